@@ -15,7 +15,25 @@ func init() {
 type oracle struct {
 	db SQLCommon
 	DefaultForeignKeyNamer
-	primaryKeySequenceNames map[string]string
+
+	// primaryKeySequenceNames tracks, per table then column, the sequence
+	// name created for that column by AutoIncrementDDL, so
+	// AutoIncrementDropDDL drops the sequence it actually created (looked up
+	// via SequenceNameFor) instead of recomputing a name that may no longer
+	// match if SequenceNameFn depends on call-time state.
+	primaryKeySequenceNames map[string]map[string]string
+
+	// UseSequences drops the Oracle 12c+ `GENERATED BY DEFAULT AS IDENTITY`
+	// clause from auto-increment columns in DataTypeOf and enables
+	// AutoIncrementDDL/AutoIncrementDropDDL, which return the SEQUENCE and
+	// BEFORE INSERT trigger statements that emulate it on Oracle 11g and
+	// earlier. CreateTable and DropTable do not run those statements; the
+	// caller executes them.
+	UseSequences bool
+
+	// SequenceNameFn, when set, overrides the default "<table>_<column>_seq"
+	// naming convention used for sequences created under UseSequences.
+	SequenceNameFn func(table, column string) string
 }
 
 func (oracle) GetName() string {
@@ -44,14 +62,22 @@ func (s *oracle) DataTypeOf(field *StructField) string {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uintptr:
 			if s.fieldCanAutoIncrement(field) {
 				field.TagSettings["AUTO_INCREMENT"] = "AUTO_INCREMENT"
-				sqlType = "NUMBER(10) GENERATED BY DEFAULT AS IDENTITY"
+				if s.UseSequences {
+					sqlType = "NUMBER(10)"
+				} else {
+					sqlType = "NUMBER(10) GENERATED BY DEFAULT AS IDENTITY"
+				}
 			} else {
 				sqlType = "NUMBER(10)"
 			}
 		case reflect.Int64, reflect.Uint64:
 			if s.fieldCanAutoIncrement(field) {
 				field.TagSettings["AUTO_INCREMENT"] = "AUTO_INCREMENT"
-				sqlType = "NUMBER(19) GENERATED BY DEFAULT AS IDENTITY"
+				if s.UseSequences {
+					sqlType = "NUMBER(19)"
+				} else {
+					sqlType = "NUMBER(19) GENERATED BY DEFAULT AS IDENTITY"
+				}
 			} else {
 				sqlType = "NUMBER(19)"
 			}
@@ -97,7 +123,15 @@ func (s oracle) fieldCanAutoIncrement(field *StructField) bool {
 
 func (s oracle) HasIndex(tableName string, indexName string) bool {
 	var count int
-	row := s.db.QueryRow("SELECT count(*) FROM user_indexes WHERE INDEX_NAME=:1 AND TABLE_NAME=:2", indexName, tableName)
+	schema, table := s.splitSchemaTable(tableName)
+	query := "SELECT count(*) FROM user_indexes WHERE INDEX_NAME=:1 AND TABLE_NAME=:2"
+	args := []interface{}{indexName, table}
+	if schema != "" {
+		query = "SELECT count(*) FROM all_indexes WHERE INDEX_NAME=:1 AND TABLE_NAME=:2 AND OWNER=:3"
+		args = []interface{}{indexName, table, schema}
+	}
+
+	row := s.db.QueryRow(query, args...)
 	err := row.Err()
 	if err != nil {
 		fmt.Printf("Error checking if index %s exists for table %s! %s\n", indexName, tableName, err)
@@ -107,23 +141,124 @@ func (s oracle) HasIndex(tableName string, indexName string) bool {
 }
 
 func (s oracle) RemoveIndex(tableName string, indexName string) error {
-	_, err := s.db.Exec(fmt.Sprintf("DROP INDEX %v ON %v", indexName, s.Quote(tableName)))
+	schema, _ := s.splitSchemaTable(tableName)
+	if schema != "" {
+		_, err := s.db.Exec(fmt.Sprintf("DROP INDEX %s.%s", s.Quote(schema), s.Quote(indexName)))
+		return err
+	}
+	_, err := s.db.Exec(fmt.Sprintf("DROP INDEX %s", s.Quote(indexName)))
 	return err
 }
 
+// OracleIndexOptions describes Oracle-specific storage and type options for
+// an index, parsed from oracle_tablespace / oracle_pctfree / oracle_compress
+// / oracle_index_type / oracle_index_expr struct tags.
+type OracleIndexOptions struct {
+	Tablespace string
+	PctFree    string
+	Compress   bool
+	IndexType  string // "BITMAP", or "" for the default b-tree index
+	Expr       string // function-based index expression, e.g. "UPPER(col)"
+}
+
+// ParseOracleIndexOptions reads the oracle_* tag keys off a StructField's
+// TagSettings into an OracleIndexOptions.
+func ParseOracleIndexOptions(field *StructField) OracleIndexOptions {
+	var opts OracleIndexOptions
+	if v, ok := field.TagSettings["ORACLE_TABLESPACE"]; ok {
+		opts.Tablespace = v
+	}
+	if v, ok := field.TagSettings["ORACLE_PCTFREE"]; ok {
+		opts.PctFree = v
+	}
+	if v, ok := field.TagSettings["ORACLE_COMPRESS"]; ok {
+		opts.Compress = v != "FALSE"
+	}
+	if v, ok := field.TagSettings["ORACLE_INDEX_TYPE"]; ok {
+		opts.IndexType = strings.ToUpper(v)
+	}
+	if v, ok := field.TagSettings["ORACLE_INDEX_EXPR"]; ok {
+		opts.Expr = v
+	}
+	return opts
+}
+
+// BuildCreateIndexSQL builds a CREATE INDEX statement for columnName on
+// tableName, honoring any OracleIndexOptions parsed off the indexed field's
+// struct tags: TABLESPACE, PCTFREE, COMPRESS, bitmap vs. b-tree, and
+// function-based indexes.
+func (s oracle) BuildCreateIndexSQL(indexName, tableName, columnName string, opts OracleIndexOptions) string {
+	indexExpr := s.Quote(columnName)
+	if opts.Expr != "" {
+		indexExpr = opts.Expr
+	}
+
+	var sql strings.Builder
+	sql.WriteString("CREATE ")
+	if opts.IndexType == "BITMAP" {
+		sql.WriteString("BITMAP ")
+	}
+	sql.WriteString(fmt.Sprintf("INDEX %s ON %s (%s)", s.Quote(indexName), s.Quote(tableName), indexExpr))
+
+	if opts.Tablespace != "" {
+		sql.WriteString(fmt.Sprintf(" TABLESPACE %s", opts.Tablespace))
+	}
+	if opts.PctFree != "" {
+		sql.WriteString(fmt.Sprintf(" PCTFREE %s", opts.PctFree))
+	}
+	if opts.Compress {
+		sql.WriteString(" COMPRESS")
+	}
+	return sql.String()
+}
+
 func (s oracle) HasForeignKey(tableName string, foreignKeyName string) bool {
-	return false
+	var count int
+	schema, table := s.splitSchemaTable(tableName)
+	if schema != "" {
+		s.db.QueryRow(
+			"SELECT count(*) FROM all_constraints c JOIN all_cons_columns cc ON cc.owner = c.owner AND cc.constraint_name = c.constraint_name WHERE c.constraint_type = 'R' AND c.constraint_name = :1 AND c.owner = :2 AND cc.table_name = :3",
+			foreignKeyName, schema, table,
+		).Scan(&count)
+	} else {
+		s.db.QueryRow(
+			"SELECT count(*) FROM user_constraints c JOIN user_cons_columns cc ON cc.constraint_name = c.constraint_name WHERE c.constraint_type = 'R' AND c.constraint_name = :1 AND cc.table_name = :2",
+			foreignKeyName, table,
+		).Scan(&count)
+	}
+	return count > 0
+}
+
+// splitSchemaTable splits a possibly schema-qualified table name ("SCHEMA.TABLE")
+// into its schema and table parts. schema is "" when tableName is unqualified,
+// in which case HasColumn/HasIndex/HasForeignKey fall back to querying the
+// user_* views, and HasTable queries all_objects with no owner filter.
+func (oracle) splitSchemaTable(tableName string) (schema, table string) {
+	if idx := strings.Index(tableName, "."); idx >= 0 {
+		return tableName[:idx], tableName[idx+1:]
+	}
+	return "", tableName
 }
 
 func (s oracle) HasTable(tableName string) bool {
 	var count int
-	s.db.QueryRow("SELECT count(*) FROM all_objects WHERE object_type = :1 and object_name = :2", "TABLE", tableName).Scan(&count)
+	schema, table := s.splitSchemaTable(tableName)
+	if schema != "" {
+		s.db.QueryRow("SELECT count(*) FROM all_objects WHERE object_type = :1 AND object_name = :2 AND owner = :3", "TABLE", table, schema).Scan(&count)
+	} else {
+		s.db.QueryRow("SELECT count(*) FROM all_objects WHERE object_type = :1 and object_name = :2", "TABLE", table).Scan(&count)
+	}
 	return count > 0
 }
 
 func (s oracle) HasColumn(tableName string, columnName string) bool {
 	var count int
-	s.db.QueryRow("SELECT count(*) FROM user_tab_cols WHERE table_name = :1 AND column_name = :2", tableName, columnName).Scan(&count)
+	schema, table := s.splitSchemaTable(tableName)
+	if schema != "" {
+		s.db.QueryRow("SELECT count(*) FROM all_tab_cols WHERE owner = :1 AND table_name = :2 AND column_name = :3", schema, table, columnName).Scan(&count)
+	} else {
+		s.db.QueryRow("SELECT count(*) FROM user_tab_cols WHERE table_name = :1 AND column_name = :2", table, columnName).Scan(&count)
+	}
 	return count > 0
 }
 
@@ -138,7 +273,17 @@ func (s oracle) RenameColumn(tableName string, columnName string, newColumName s
 }
 
 func (s oracle) CurrentDatabase() (name string) {
-	s.db.QueryRow("SELECT DB_NAME() AS [Current Database]").Scan(&name)
+	s.db.QueryRow("SELECT sys_context('USERENV', 'DB_NAME') FROM dual").Scan(&name)
+	return
+}
+
+// CurrentSchema returns the schema of the connected Oracle session. Oracle
+// has no separate "database" concept within an instance, so for migration
+// purposes the schema is more meaningful than CurrentDatabase's database
+// name. It is not consulted by HasTable/HasColumn/HasIndex; see
+// splitSchemaTable for what those query without a schema-qualified name.
+func (s oracle) CurrentSchema() (name string) {
+	s.db.QueryRow("SELECT sys_context('USERENV', 'CURRENT_SCHEMA') FROM dual").Scan(&name)
 	return
 }
 
@@ -176,9 +321,109 @@ func (o oracle) LastInsertIDReturningSuffix(tableName, columnName string) string
 	if columnName == "*" {
 		return ""
 	}
+	// Works whether the column is populated by a 12c+ identity column or,
+	// under UseSequences, by the BEFORE INSERT trigger from AutoIncrementDDL.
 	return " RETURNING " + columnName + " INTO :id"
 }
 
+// sequenceName returns the name of the sequence backing an auto-increment
+// column under UseSequences, honoring SequenceNameFn if set and truncating
+// to Oracle's 30-byte identifier limit otherwise.
+func (s *oracle) sequenceName(tableName, columnName string) string {
+	if s.SequenceNameFn != nil {
+		return s.SequenceNameFn(tableName, columnName)
+	}
+	return truncateOracleIdentifier(fmt.Sprintf("%s_%s_seq", tableName, columnName))
+}
+
+// triggerName returns the name of the BEFORE INSERT trigger paired with the
+// sequence returned by sequenceName.
+func (s *oracle) triggerName(tableName, columnName string) string {
+	return truncateOracleIdentifier(fmt.Sprintf("%s_%s_trg", tableName, columnName))
+}
+
+// AutoIncrementDDL returns the CREATE SEQUENCE and CREATE OR REPLACE TRIGGER
+// statements needed to emulate an auto-increment column on Oracle releases
+// that predate 12c identity columns. It is a no-op unless UseSequences is
+// set. The sequence name is recorded in primaryKeySequenceNames, keyed by
+// table then column, so AutoIncrementDropDDL can look it up again (via
+// SequenceNameFor). The returned statements are not executed here.
+func (s *oracle) AutoIncrementDDL(tableName, columnName string) []string {
+	if !s.UseSequences {
+		return nil
+	}
+
+	seqName := s.sequenceName(tableName, columnName)
+	if s.primaryKeySequenceNames == nil {
+		s.primaryKeySequenceNames = map[string]map[string]string{}
+	}
+	if s.primaryKeySequenceNames[tableName] == nil {
+		s.primaryKeySequenceNames[tableName] = map[string]string{}
+	}
+	s.primaryKeySequenceNames[tableName][columnName] = seqName
+
+	trgName := s.triggerName(tableName, columnName)
+	return []string{
+		fmt.Sprintf("CREATE SEQUENCE %s START WITH 1 INCREMENT BY 1 NOCACHE", s.Quote(seqName)),
+		fmt.Sprintf(
+			"CREATE OR REPLACE TRIGGER %s BEFORE INSERT ON %s FOR EACH ROW WHEN (NEW.%s IS NULL) BEGIN SELECT %s.NEXTVAL INTO :NEW.%s FROM dual; END;",
+			s.Quote(trgName), s.Quote(tableName), columnName, s.Quote(seqName), columnName,
+		),
+	}
+}
+
+// SequenceNameFor returns the sequence name AutoIncrementDDL created for
+// tableName's columnName, and whether one has been recorded. Callers that
+// need to reference the sequence after table creation (including
+// AutoIncrementDropDDL) should use this rather than recomputing the name,
+// since a custom SequenceNameFn isn't guaranteed to be a pure function of
+// its arguments.
+func (s *oracle) SequenceNameFor(tableName, columnName string) (string, bool) {
+	columns, ok := s.primaryKeySequenceNames[tableName]
+	if !ok {
+		return "", false
+	}
+	name, ok := columns[columnName]
+	return name, ok
+}
+
+// AutoIncrementDropDDL returns the statements that undo AutoIncrementDDL:
+// the DROP TRIGGER/DROP SEQUENCE pair for a column that was created with it.
+// It is a no-op unless UseSequences is set.
+func (s *oracle) AutoIncrementDropDDL(tableName, columnName string) []string {
+	if !s.UseSequences {
+		return nil
+	}
+
+	seqName, ok := s.SequenceNameFor(tableName, columnName)
+	if !ok {
+		seqName = s.sequenceName(tableName, columnName)
+	}
+	trgName := s.triggerName(tableName, columnName)
+
+	if columns := s.primaryKeySequenceNames[tableName]; columns != nil {
+		delete(columns, columnName)
+		if len(columns) == 0 {
+			delete(s.primaryKeySequenceNames, tableName)
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("DROP TRIGGER %s", s.Quote(trgName)),
+		fmt.Sprintf("DROP SEQUENCE %s", s.Quote(seqName)),
+	}
+}
+
+// truncateOracleIdentifier shortens name to fit Oracle's 30-byte identifier
+// limit (pre-12.2), which CREATE SEQUENCE and CREATE TRIGGER both enforce.
+func truncateOracleIdentifier(name string) string {
+	const maxLen = 30
+	if len(name) <= maxLen {
+		return name
+	}
+	return name[:maxLen]
+}
+
 func (oracle) DefaultValueStr() string {
 	return "DEFAULT VALUES"
 }
@@ -202,6 +447,47 @@ func (oracle) ColumnDefinitionNullFirst() bool {
 	return false
 }
 
+// SupportsTransactionalDDL reports that Oracle implicitly commits the
+// current transaction before and after DDL statements, so a migration
+// runner cannot roll back DDL by aborting the transaction around it and
+// must instead mark migrations applied only after the DDL itself succeeds.
+func (oracle) SupportsTransactionalDDL() bool {
+	return false
+}
+
+// BuildBulkInsertSQL builds a single INSERT ALL statement that inserts every
+// row in one round-trip, since Oracle rejects the multi-row
+// "VALUES (...), (...), ..." syntax GORM emits for other dialects. The whole
+// statement commits or rolls back atomically, unlike per-row inserts. It is a
+// dialect-level building block: GORM's create callbacks do not call it, so
+// the caller executes the returned statement and args itself. All rows go
+// into one statement; rows are not split into batches.
+func (s oracle) BuildBulkInsertSQL(tableName string, columns []string, rows [][]interface{}) (string, []interface{}) {
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = s.Quote(column)
+	}
+	columnList := strings.Join(quotedColumns, ", ")
+
+	var sql strings.Builder
+	var args []interface{}
+	sql.WriteString("INSERT ALL")
+
+	bindIndex := 1
+	for _, row := range rows {
+		placeholders := make([]string, len(row))
+		for i := range row {
+			placeholders[i] = s.BindVar(bindIndex)
+			bindIndex++
+		}
+		sql.WriteString(fmt.Sprintf(" INTO %s (%s) VALUES (%s)", s.Quote(tableName), columnList, strings.Join(placeholders, ", ")))
+		args = append(args, row...)
+	}
+
+	sql.WriteString(" SELECT 1 FROM DUAL")
+	return sql.String(), args
+}
+
 func (oracle) ConvertSQLVar(value interface{}) interface{} {
 	t := reflect.TypeOf(value)
 	kind := t.Kind()
@@ -0,0 +1,49 @@
+package gorm
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOracleBuildBulkInsertSQLBindOrdering(t *testing.T) {
+	o := &oracle{}
+	sql, args := o.BuildBulkInsertSQL("users", []string{"id", "name"}, [][]interface{}{
+		{1, "alice"},
+		{2, "bob"},
+	})
+
+	want := `INSERT ALL INTO "users" ("id", "name") VALUES (:1, :2) INTO "users" ("id", "name") VALUES (:3, :4) SELECT 1 FROM DUAL`
+	if sql != want {
+		t.Fatalf("BuildBulkInsertSQL SQL =\n%q\nwant\n%q", sql, want)
+	}
+
+	wantArgs := []interface{}{1, "alice", 2, "bob"}
+	if fmt.Sprint(args) != fmt.Sprint(wantArgs) {
+		t.Fatalf("BuildBulkInsertSQL args = %v, want %v", args, wantArgs)
+	}
+}
+
+func TestOracleBuildBulkInsertSQLExecSucceeds(t *testing.T) {
+	db := &fakeSQLCommon{}
+	o := &oracle{db: db}
+
+	rows := [][]interface{}{{1, "a"}, {2, "b"}, {3, "c"}}
+	query, args := o.BuildBulkInsertSQL("users", []string{"id", "name"}, rows)
+	if _, err := db.Exec(query, args...); err != nil {
+		t.Fatalf("Exec of BuildBulkInsertSQL output returned unexpected error: %v", err)
+	}
+	if len(db.execs) != 1 {
+		t.Fatalf("expected a single INSERT ALL round-trip for the whole batch, got %d execs", len(db.execs))
+	}
+}
+
+func TestOracleBuildBulkInsertSQLExecSurfacesConstraintViolation(t *testing.T) {
+	db := &fakeSQLCommon{failSQL: "INSERT ALL"}
+	o := &oracle{db: db}
+
+	rows := [][]interface{}{{1, "a"}, {2, "b"}}
+	query, args := o.BuildBulkInsertSQL("users", []string{"id", "name"}, rows)
+	if _, err := db.Exec(query, args...); err == nil {
+		t.Fatalf("expected Exec to surface a constraint violation from the batch statement")
+	}
+}
@@ -0,0 +1,71 @@
+package gorm
+
+import "testing"
+
+func TestParseOracleIndexOptionsReadsOracleTags(t *testing.T) {
+	field := &StructField{TagSettings: map[string]string{
+		"ORACLE_TABLESPACE": "USERS",
+		"ORACLE_PCTFREE":    "10",
+		"ORACLE_COMPRESS":   "COMPRESS",
+		"ORACLE_INDEX_TYPE": "bitmap",
+		"ORACLE_INDEX_EXPR": "UPPER(email)",
+	}}
+
+	opts := ParseOracleIndexOptions(field)
+	if opts.Tablespace != "USERS" {
+		t.Errorf("Tablespace = %q, want USERS", opts.Tablespace)
+	}
+	if opts.PctFree != "10" {
+		t.Errorf("PctFree = %q, want 10", opts.PctFree)
+	}
+	if !opts.Compress {
+		t.Errorf("Compress = false, want true")
+	}
+	if opts.IndexType != "BITMAP" {
+		t.Errorf("IndexType = %q, want BITMAP", opts.IndexType)
+	}
+	if opts.Expr != "UPPER(email)" {
+		t.Errorf("Expr = %q, want UPPER(email)", opts.Expr)
+	}
+}
+
+func TestOracleBuildCreateIndexSQLFunctionBasedBitmap(t *testing.T) {
+	o := oracle{}
+	sql := o.BuildCreateIndexSQL("idx_users_email_upper", "users", "email", OracleIndexOptions{
+		IndexType:  "BITMAP",
+		Expr:       "UPPER(email)",
+		Tablespace: "USERS",
+		PctFree:    "10",
+		Compress:   true,
+	})
+
+	want := `CREATE BITMAP INDEX "idx_users_email_upper" ON "users" (UPPER(email)) TABLESPACE USERS PCTFREE 10 COMPRESS`
+	if sql != want {
+		t.Fatalf("BuildCreateIndexSQL =\n%q\nwant\n%q", sql, want)
+	}
+}
+
+func TestOracleBuildCreateIndexSQLExecSucceeds(t *testing.T) {
+	db := &fakeSQLCommon{}
+	o := oracle{db: db}
+	field := &StructField{
+		DBName: "email",
+		TagSettings: map[string]string{
+			"ORACLE_INDEX_TYPE": "BITMAP",
+		},
+	}
+
+	opts := ParseOracleIndexOptions(field)
+	stmt := o.BuildCreateIndexSQL("idx_users_email", "users", field.DBName, opts)
+	if _, err := db.Exec(stmt); err != nil {
+		t.Fatalf("Exec of BuildCreateIndexSQL output returned unexpected error: %v", err)
+	}
+
+	if len(db.execs) != 1 {
+		t.Fatalf("expected one Exec, got %d", len(db.execs))
+	}
+	want := `CREATE BITMAP INDEX "idx_users_email" ON "users" ("email")`
+	if db.execs[0].query != want {
+		t.Fatalf("Exec query = %q, want %q", db.execs[0].query, want)
+	}
+}
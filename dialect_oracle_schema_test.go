@@ -0,0 +1,158 @@
+package gorm
+
+import "testing"
+
+func TestOracleHasForeignKeyUnqualified(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: int64(1)}
+	o := oracle{db: db}
+
+	if !o.HasForeignKey("users", "fk_users_account_id") {
+		t.Fatalf("expected HasForeignKey to report true")
+	}
+	if len(db.execs) != 1 {
+		t.Fatalf("expected one query, got %d", len(db.execs))
+	}
+	want := "SELECT count(*) FROM user_constraints c JOIN user_cons_columns cc ON cc.constraint_name = c.constraint_name WHERE c.constraint_type = 'R' AND c.constraint_name = :1 AND cc.table_name = :2"
+	if db.execs[0].query != want {
+		t.Fatalf("HasForeignKey query = %q, want %q", db.execs[0].query, want)
+	}
+	wantArgs := []interface{}{"fk_users_account_id", "users"}
+	if len(db.execs[0].args) != len(wantArgs) || db.execs[0].args[0] != wantArgs[0] || db.execs[0].args[1] != wantArgs[1] {
+		t.Fatalf("HasForeignKey args = %v, want %v", db.execs[0].args, wantArgs)
+	}
+}
+
+func TestOracleHasForeignKeySchemaQualified(t *testing.T) {
+	db := &fakeSQLCommon{} // no rows => not found
+	o := oracle{db: db}
+
+	if o.HasForeignKey("acct.users", "fk_users_account_id") {
+		t.Fatalf("expected HasForeignKey to report false when the fake returns no rows")
+	}
+	want := "SELECT count(*) FROM all_constraints c JOIN all_cons_columns cc ON cc.owner = c.owner AND cc.constraint_name = c.constraint_name WHERE c.constraint_type = 'R' AND c.constraint_name = :1 AND c.owner = :2 AND cc.table_name = :3"
+	if len(db.execs) != 1 || db.execs[0].query != want {
+		t.Fatalf("HasForeignKey query = %v, want %q", db.execs, want)
+	}
+	wantArgs := []interface{}{"fk_users_account_id", "acct", "users"}
+	if len(db.execs[0].args) != len(wantArgs) {
+		t.Fatalf("HasForeignKey args = %v, want %v", db.execs[0].args, wantArgs)
+	}
+	for i, want := range wantArgs {
+		if db.execs[0].args[i] != want {
+			t.Fatalf("HasForeignKey args = %v, want %v", db.execs[0].args, wantArgs)
+		}
+	}
+}
+
+func TestOracleCurrentDatabase(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: "ORCLPDB1"}
+	o := oracle{db: db}
+
+	if got := o.CurrentDatabase(); got != "ORCLPDB1" {
+		t.Fatalf("CurrentDatabase() = %q, want ORCLPDB1", got)
+	}
+	want := "SELECT sys_context('USERENV', 'DB_NAME') FROM dual"
+	if len(db.execs) != 1 || db.execs[0].query != want {
+		t.Fatalf("CurrentDatabase query = %v, want %q", db.execs, want)
+	}
+}
+
+func TestOracleCurrentSchema(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: "APP_OWNER"}
+	o := oracle{db: db}
+
+	if got := o.CurrentSchema(); got != "APP_OWNER" {
+		t.Fatalf("CurrentSchema() = %q, want APP_OWNER", got)
+	}
+	want := "SELECT sys_context('USERENV', 'CURRENT_SCHEMA') FROM dual"
+	if len(db.execs) != 1 || db.execs[0].query != want {
+		t.Fatalf("CurrentSchema query = %v, want %q", db.execs, want)
+	}
+}
+
+func TestOracleHasTableSchemaQualifiedVsUnqualified(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: int64(1)}
+	o := oracle{db: db}
+
+	if !o.HasTable("users") {
+		t.Fatalf("expected HasTable(\"users\") to report true")
+	}
+	want := "SELECT count(*) FROM all_objects WHERE object_type = :1 and object_name = :2"
+	if db.execs[0].query != want {
+		t.Fatalf("unqualified HasTable query = %q, want %q", db.execs[0].query, want)
+	}
+
+	db = &fakeSQLCommon{queryResult: int64(1)}
+	o = oracle{db: db}
+	if !o.HasTable("acct.users") {
+		t.Fatalf("expected HasTable(\"acct.users\") to report true")
+	}
+	want = "SELECT count(*) FROM all_objects WHERE object_type = :1 AND object_name = :2 AND owner = :3"
+	if db.execs[0].query != want {
+		t.Fatalf("schema-qualified HasTable query = %q, want %q", db.execs[0].query, want)
+	}
+	wantArgs := []interface{}{"TABLE", "users", "acct"}
+	for i, want := range wantArgs {
+		if db.execs[0].args[i] != want {
+			t.Fatalf("schema-qualified HasTable args = %v, want %v", db.execs[0].args, wantArgs)
+		}
+	}
+}
+
+func TestOracleHasColumnSchemaQualifiedVsUnqualified(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: int64(1)}
+	o := oracle{db: db}
+
+	if !o.HasColumn("users", "email") {
+		t.Fatalf("expected HasColumn(\"users\", \"email\") to report true")
+	}
+	want := "SELECT count(*) FROM user_tab_cols WHERE table_name = :1 AND column_name = :2"
+	if db.execs[0].query != want {
+		t.Fatalf("unqualified HasColumn query = %q, want %q", db.execs[0].query, want)
+	}
+
+	db = &fakeSQLCommon{queryResult: int64(1)}
+	o = oracle{db: db}
+	if !o.HasColumn("acct.users", "email") {
+		t.Fatalf("expected HasColumn(\"acct.users\", \"email\") to report true")
+	}
+	want = "SELECT count(*) FROM all_tab_cols WHERE owner = :1 AND table_name = :2 AND column_name = :3"
+	if db.execs[0].query != want {
+		t.Fatalf("schema-qualified HasColumn query = %q, want %q", db.execs[0].query, want)
+	}
+	wantArgs := []interface{}{"acct", "users", "email"}
+	for i, want := range wantArgs {
+		if db.execs[0].args[i] != want {
+			t.Fatalf("schema-qualified HasColumn args = %v, want %v", db.execs[0].args, wantArgs)
+		}
+	}
+}
+
+func TestOracleHasIndexSchemaQualifiedVsUnqualified(t *testing.T) {
+	db := &fakeSQLCommon{queryResult: int64(1)}
+	o := oracle{db: db}
+
+	if !o.HasIndex("users", "idx_users_email") {
+		t.Fatalf("expected HasIndex(\"users\", ...) to report true")
+	}
+	want := "SELECT count(*) FROM user_indexes WHERE INDEX_NAME=:1 AND TABLE_NAME=:2"
+	if db.execs[0].query != want {
+		t.Fatalf("unqualified HasIndex query = %q, want %q", db.execs[0].query, want)
+	}
+
+	db = &fakeSQLCommon{queryResult: int64(1)}
+	o = oracle{db: db}
+	if !o.HasIndex("acct.users", "idx_users_email") {
+		t.Fatalf("expected HasIndex(\"acct.users\", ...) to report true")
+	}
+	want = "SELECT count(*) FROM all_indexes WHERE INDEX_NAME=:1 AND TABLE_NAME=:2 AND OWNER=:3"
+	if db.execs[0].query != want {
+		t.Fatalf("schema-qualified HasIndex query = %q, want %q", db.execs[0].query, want)
+	}
+	wantArgs := []interface{}{"idx_users_email", "users", "acct"}
+	for i, want := range wantArgs {
+		if db.execs[0].args[i] != want {
+			t.Fatalf("schema-qualified HasIndex args = %v, want %v", db.execs[0].args, wantArgs)
+		}
+	}
+}
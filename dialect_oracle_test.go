@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOracleAutoIncrementDDLNoopWithoutUseSequences(t *testing.T) {
+	o := &oracle{}
+	if stmts := o.AutoIncrementDDL("users", "id"); stmts != nil {
+		t.Fatalf("expected no DDL when UseSequences is unset, got %v", stmts)
+	}
+}
+
+func TestOracleAutoIncrementDropDDLUsesRecordedSequenceName(t *testing.T) {
+	o := &oracle{UseSequences: true, SequenceNameFn: func(table, column string) string {
+		return "custom_" + table + "_" + column
+	}}
+
+	o.AutoIncrementDDL("users", "id")
+
+	name, ok := o.SequenceNameFor("users", "id")
+	if !ok || name != "custom_users_id" {
+		t.Fatalf("SequenceNameFor(users, id) = (%q, %v), want (\"custom_users_id\", true)", name, ok)
+	}
+
+	dropStmts := o.AutoIncrementDropDDL("users", "id")
+	if len(dropStmts) != 2 || !strings.Contains(dropStmts[1], "custom_users_id") {
+		t.Fatalf("expected the DROP SEQUENCE statement to reference recorded sequence name custom_users_id, got %v", dropStmts)
+	}
+
+	if _, ok := o.SequenceNameFor("users", "id"); ok {
+		t.Fatalf("expected SequenceNameFor to forget users.id after AutoIncrementDropDDL")
+	}
+}
+
+func TestOracleAutoIncrementDDLTracksMultipleColumnsPerTable(t *testing.T) {
+	o := &oracle{UseSequences: true}
+
+	o.AutoIncrementDDL("orders", "id")
+	o.AutoIncrementDDL("orders", "line_no")
+
+	idSeq, ok := o.SequenceNameFor("orders", "id")
+	if !ok || idSeq != "orders_id_seq" {
+		t.Fatalf("SequenceNameFor(orders, id) = (%q, %v), want (\"orders_id_seq\", true)", idSeq, ok)
+	}
+	lineSeq, ok := o.SequenceNameFor("orders", "line_no")
+	if !ok || lineSeq != "orders_line_no_seq" {
+		t.Fatalf("SequenceNameFor(orders, line_no) = (%q, %v), want (\"orders_line_no_seq\", true)", lineSeq, ok)
+	}
+
+	o.AutoIncrementDropDDL("orders", "id")
+	if _, ok := o.SequenceNameFor("orders", "line_no"); !ok {
+		t.Fatalf("dropping orders.id should not clobber the recorded sequence for orders.line_no")
+	}
+}
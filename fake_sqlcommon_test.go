@@ -0,0 +1,166 @@
+package gorm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// fakeSQLCommon is a minimal SQLCommon used to unit test dialect code paths
+// without a real database connection. It is backed by a fake database/sql
+// driver rather than stubbing Exec/QueryRow directly, so QueryRow(...).Scan
+// behaves exactly like it would against a real driver (required for
+// HasTable/HasForeignKey/CurrentDatabase/etc., which all Scan a QueryRow
+// result).
+type fakeSQLCommon struct {
+	execs   []fakeExec
+	failSQL string // Exec/Query returns an error for any query containing this substring
+
+	// queryResult is returned as the sole column of the sole row for every
+	// Query/QueryRow call, so tests can drive HasTable/HasForeignKey/etc. to
+	// see either a hit (e.g. int64(1)) or a miss (leave nil), or feed back a
+	// string for CurrentDatabase/CurrentSchema.
+	queryResult driver.Value
+
+	once sync.Once
+	db   *sql.DB
+}
+
+type fakeExec struct {
+	query string
+	args  []interface{}
+}
+
+func (f *fakeSQLCommon) conn() *sql.DB {
+	f.once.Do(func() {
+		registerFakeDriverOnce()
+		name := fmt.Sprintf("fake-%d", atomic.AddInt64(&fakeDriverSeq, 1))
+		fakeDriverRegistry.Store(name, f)
+		db, err := sql.Open("gorm-fake", name)
+		if err != nil {
+			panic(err)
+		}
+		f.db = db
+	})
+	return f.db
+}
+
+func (f *fakeSQLCommon) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return f.conn().Exec(query, args...)
+}
+
+func (f *fakeSQLCommon) Prepare(query string) (*sql.Stmt, error) {
+	return f.conn().Prepare(query)
+}
+
+func (f *fakeSQLCommon) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return f.conn().Query(query, args...)
+}
+
+func (f *fakeSQLCommon) QueryRow(query string, args ...interface{}) *sql.Row {
+	return f.conn().QueryRow(query, args...)
+}
+
+func (f *fakeSQLCommon) record(query string, args []driver.Value) error {
+	converted := make([]interface{}, len(args))
+	for i, a := range args {
+		converted[i] = a
+	}
+	f.execs = append(f.execs, fakeExec{query: query, args: converted})
+	if f.failSQL != "" && strings.Contains(query, f.failSQL) {
+		return errors.New("ORA-00001: unique constraint violated")
+	}
+	return nil
+}
+
+type fakeResult struct{}
+
+func (fakeResult) LastInsertId() (int64, error) { return 0, nil }
+func (fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+// --- fake database/sql/driver wiring ---
+//
+// database/sql requires a registered driver.Driver to open connections; the
+// dsn string passed to sql.Open is used as a key back to the *fakeSQLCommon
+// instance that should back that connection, so every fakeSQLCommon gets its
+// own isolated record of execs/queries despite sharing one registered driver.
+
+var (
+	fakeDriverOnce     sync.Once
+	fakeDriverSeq      int64
+	fakeDriverRegistry sync.Map // name string -> *fakeSQLCommon
+)
+
+func registerFakeDriverOnce() {
+	fakeDriverOnce.Do(func() {
+		sql.Register("gorm-fake", fakeDriver{})
+	})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	v, ok := fakeDriverRegistry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("fake driver: unknown dsn %q", name)
+	}
+	return fakeConn{fake: v.(*fakeSQLCommon)}, nil
+}
+
+type fakeConn struct{ fake *fakeSQLCommon }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{fake: c.fake, query: query}, nil
+}
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	fake  *fakeSQLCommon
+	query string
+}
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.fake.record(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.fake.record(s.query, args); err != nil {
+		return nil, err
+	}
+	return &fakeRows{value: s.fake.queryResult}, nil
+}
+
+// fakeRows yields a single row with a single column when queryResult is set,
+// and no rows at all when it's nil (matching "not found").
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"result"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done || r.value == nil {
+		return io.EOF
+	}
+	dest[0] = r.value
+	r.done = true
+	return nil
+}
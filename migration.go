@@ -0,0 +1,231 @@
+package gorm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Migration is a single versioned schema change, identified by a
+// timestamp-style id (e.g. "20240115093000") so migrations sort and apply in
+// the order they were written.
+type Migration struct {
+	ID   string
+	Up   func(*DB) error
+	Down func(*DB) error
+}
+
+// schemaMigration records that a Migration has been applied.
+type schemaMigration struct {
+	ID string `gorm:"primary_key"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator applies a set of registered Migrations against a *DB, recording
+// progress in a schema_migrations table so MigrateUp/MigrateTo only run what
+// hasn't been applied yet.
+type Migrator struct {
+	db         *DB
+	migrations []Migration
+}
+
+// NewMigrator returns a Migrator bound to db.
+func NewMigrator(db *DB) *Migrator {
+	return &Migrator{db: db}
+}
+
+// Register adds a migration to the set Migrator will run. Ids should be
+// monotonically increasing (timestamp-style is recommended); migrations are
+// always applied in ascending id order regardless of registration order.
+func (m *Migrator) Register(id string, up, down func(*DB) error) {
+	m.migrations = append(m.migrations, Migration{ID: id, Up: up, Down: down})
+}
+
+func (m *Migrator) sorted() []Migration {
+	sorted := make([]Migration, len(m.migrations))
+	copy(sorted, m.migrations)
+	sort.Slice(sorted, func(i, j int) bool { return compareMigrationIDs(sorted[i].ID, sorted[j].ID) < 0 })
+	return sorted
+}
+
+// compareMigrationIDs orders migration ids the way Register documents them:
+// numerically, so that timestamp-style ids compare correctly regardless of
+// width (e.g. "9" sorts before "10", not after). Ids that aren't valid
+// integers fall back to a lexical comparison rather than erroring out.
+func compareMigrationIDs(a, b string) int {
+	ai, aErr := strconv.ParseInt(a, 10, 64)
+	bi, bErr := strconv.ParseInt(b, 10, 64)
+	if aErr == nil && bErr == nil {
+		switch {
+		case ai < bi:
+			return -1
+		case ai > bi:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// transactionalDDLDialect is implemented by dialects that report whether DDL
+// statements can be rolled back as part of a transaction; Oracle implements
+// it and reports false because of its implicit-commit-on-DDL behavior.
+// Dialects that don't implement it are assumed to support transactional DDL.
+type transactionalDDLDialect interface {
+	SupportsTransactionalDDL() bool
+}
+
+func (m *Migrator) supportsTransactionalDDL() bool {
+	if d, ok := m.db.Dialect().(transactionalDDLDialect); ok {
+		return d.SupportsTransactionalDDL()
+	}
+	return true
+}
+
+func (m *Migrator) ensureMigrationsTable() error {
+	if !m.db.HasTable(&schemaMigration{}) {
+		return m.db.CreateTable(&schemaMigration{}).Error
+	}
+	return nil
+}
+
+func (m *Migrator) applied() (map[string]bool, error) {
+	var rows []schemaMigration
+	if err := m.db.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		applied[row.ID] = true
+	}
+	return applied, nil
+}
+
+// MigrateUp applies all pending migrations, in ascending id order.
+func (m *Migrator) MigrateUp() error {
+	return m.MigrateTo("")
+}
+
+// MigrateTo applies pending migrations up to and including id. An empty id
+// means "the latest migration".
+func (m *Migrator) MigrateTo(id string) error {
+	if err := m.ensureMigrationsTable(); err != nil {
+		return err
+	}
+
+	applied, err := m.applied()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range m.sorted() {
+		if applied[migration.ID] {
+			continue
+		}
+		if id != "" && compareMigrationIDs(migration.ID, id) > 0 {
+			break
+		}
+		if err := m.runUp(migration); err != nil {
+			return fmt.Errorf("migrate up %s: %w", migration.ID, err)
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts the applied migration with the highest id.
+func (m *Migrator) MigrateDown() error {
+	applied, err := m.applied()
+	if err != nil {
+		return err
+	}
+
+	sorted := m.sorted()
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if applied[sorted[i].ID] {
+			return m.runDown(sorted[i])
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) runUp(migration Migration) error {
+	if !m.supportsTransactionalDDL() {
+		if err := migration.Up(m.db); err != nil {
+			return err
+		}
+		return m.db.Create(&schemaMigration{ID: migration.ID}).Error
+	}
+
+	tx := m.db.Begin()
+	if err := migration.Up(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Create(&schemaMigration{ID: migration.ID}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+func (m *Migrator) runDown(migration Migration) error {
+	if migration.Down == nil {
+		return fmt.Errorf("migration %s has no Down function", migration.ID)
+	}
+
+	if !m.supportsTransactionalDDL() {
+		if err := migration.Down(m.db); err != nil {
+			return err
+		}
+		return m.db.Where("id = ?", migration.ID).Delete(&schemaMigration{}).Error
+	}
+
+	tx := m.db.Begin()
+	if err := migration.Down(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("id = ?", migration.ID).Delete(&schemaMigration{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// MigrationStatus describes whether a registered migration has been applied.
+type MigrationStatus struct {
+	ID      string
+	Applied bool
+}
+
+// Status reports, for every registered migration in order, whether it has
+// been applied yet.
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	if err := m.ensureMigrationsTable(); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.sorted() {
+		statuses = append(statuses, MigrationStatus{ID: migration.ID, Applied: applied[migration.ID]})
+	}
+	return statuses, nil
+}
@@ -0,0 +1,44 @@
+package gorm
+
+import "testing"
+
+func TestCompareMigrationIDsOrdersNumerically(t *testing.T) {
+	if compareMigrationIDs("9", "10") >= 0 {
+		t.Fatalf(`compareMigrationIDs("9", "10") >= 0, want "9" to sort before "10"`)
+	}
+	if compareMigrationIDs("20240115093000", "20240101000000") <= 0 {
+		t.Fatalf("compareMigrationIDs did not order later timestamp after earlier one")
+	}
+	if compareMigrationIDs("20240101000000", "20240101000000") != 0 {
+		t.Fatalf("compareMigrationIDs of equal ids should be 0")
+	}
+}
+
+func TestCompareMigrationIDsFallsBackToLexicalForNonNumericIDs(t *testing.T) {
+	if compareMigrationIDs("add_users", "add_users") != 0 {
+		t.Fatalf("compareMigrationIDs of identical non-numeric ids should be 0")
+	}
+	if compareMigrationIDs("add_users", "rename_users") >= 0 {
+		t.Fatalf("expected lexical fallback to order add_users before rename_users")
+	}
+}
+
+func TestMigratorSortedOrdersByNumericIDNotLexically(t *testing.T) {
+	m := &Migrator{}
+	m.Register("20240201000000", nil, nil)
+	m.Register("9", nil, nil)
+	m.Register("10", nil, nil)
+
+	sorted := m.sorted()
+	got := make([]string, len(sorted))
+	for i, migration := range sorted {
+		got[i] = migration.ID
+	}
+
+	want := []string{"9", "10", "20240201000000"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sorted() order = %v, want %v", got, want)
+		}
+	}
+}